@@ -0,0 +1,214 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmSQLDatabaseLongTermRetentionBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSQLDatabaseLongTermRetentionBackupsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        azure.NormalizeLocation,
+				DiffSuppressFunc: azure.SuppressLocationDiff,
+				AtLeastOneOf:     []string{"location", "resource_group_name"},
+			},
+
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: []string{"location", "resource_group_name"},
+			},
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"only_latest_per_database": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_expiration_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSQLDatabaseLongTermRetentionBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.LongTermRetentionBackupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	onlyLatestPerDatabase := d.Get("only_latest_per_database").(bool)
+
+	backups := make([]interface{}, 0)
+
+	// Long Term Retention Backups outlive the server/database they were taken from, so the
+	// SDK addresses them by subscription + location by default - the `ListByResourceGroup*`
+	// variants additionally scope that same lookup to a resource group.
+	switch {
+	case databaseName != "" && serverName != "" && resourceGroup != "":
+		result, err := client.ListByResourceGroupDatabaseComplete(ctx, resourceGroup, serverName, databaseName, &onlyLatestPerDatabase, "")
+		if err != nil {
+			return fmt.Errorf("Error listing Long Term Retention Backups for Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+		}
+		for result.NotDone() {
+			backups = append(backups, flattenArmSQLLongTermRetentionBackup(result.Value()))
+			if err := result.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Long Term Retention Backups for Database %q (Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+			}
+		}
+
+	case databaseName != "" && serverName != "" && location != "":
+		result, err := client.ListByDatabaseComplete(ctx, location, serverName, databaseName, &onlyLatestPerDatabase, "")
+		if err != nil {
+			return fmt.Errorf("Error listing Long Term Retention Backups for Database %q (Server %q / Location %q): %+v", databaseName, serverName, location, err)
+		}
+		for result.NotDone() {
+			backups = append(backups, flattenArmSQLLongTermRetentionBackup(result.Value()))
+			if err := result.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Long Term Retention Backups for Database %q (Server %q / Location %q): %+v", databaseName, serverName, location, err)
+			}
+		}
+
+	case serverName != "" && resourceGroup != "":
+		result, err := client.ListByResourceGroupServerComplete(ctx, resourceGroup, serverName, &onlyLatestPerDatabase, "", "")
+		if err != nil {
+			return fmt.Errorf("Error listing Long Term Retention Backups for Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+		for result.NotDone() {
+			backups = append(backups, flattenArmSQLLongTermRetentionBackup(result.Value()))
+			if err := result.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Long Term Retention Backups for Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+			}
+		}
+
+	case serverName != "" && location != "":
+		result, err := client.ListByServerComplete(ctx, location, serverName, &onlyLatestPerDatabase, "", "")
+		if err != nil {
+			return fmt.Errorf("Error listing Long Term Retention Backups for Server %q (Location %q): %+v", serverName, location, err)
+		}
+		for result.NotDone() {
+			backups = append(backups, flattenArmSQLLongTermRetentionBackup(result.Value()))
+			if err := result.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Long Term Retention Backups for Server %q (Location %q): %+v", serverName, location, err)
+			}
+		}
+
+	case resourceGroup != "" && location != "":
+		result, err := client.ListByResourceGroupLocationComplete(ctx, resourceGroup, location, &onlyLatestPerDatabase, "", "")
+		if err != nil {
+			return fmt.Errorf("Error listing Long Term Retention Backups for Location %q (Resource Group %q): %+v", location, resourceGroup, err)
+		}
+		for result.NotDone() {
+			backups = append(backups, flattenArmSQLLongTermRetentionBackup(result.Value()))
+			if err := result.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Long Term Retention Backups for Location %q (Resource Group %q): %+v", location, resourceGroup, err)
+			}
+		}
+
+	case location != "":
+		result, err := client.ListByLocationComplete(ctx, location, &onlyLatestPerDatabase, "", "")
+		if err != nil {
+			return fmt.Errorf("Error listing Long Term Retention Backups for Location %q: %+v", location, err)
+		}
+		for result.NotDone() {
+			backups = append(backups, flattenArmSQLLongTermRetentionBackup(result.Value()))
+			if err := result.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Error listing Long Term Retention Backups for Location %q: %+v", location, err)
+			}
+		}
+
+	default:
+		return fmt.Errorf("`location` must also be specified when filtering by `resource_group_name` without a `server_name`")
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("backups", backups); err != nil {
+		return fmt.Errorf("Error setting `backups`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmSQLLongTermRetentionBackup(input sql.LongTermRetentionBackup) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+
+	if props := input.LongTermRetentionBackupProperties; props != nil {
+		if props.ServerName != nil {
+			output["server_name"] = *props.ServerName
+		}
+		if props.DatabaseName != nil {
+			output["database_name"] = *props.DatabaseName
+		}
+		if props.BackupTime != nil {
+			output["backup_time"] = props.BackupTime.String()
+		}
+		if props.BackupExpirationTime != nil {
+			output["backup_expiration_time"] = props.BackupExpirationTime.String()
+		}
+	}
+
+	return output
+}
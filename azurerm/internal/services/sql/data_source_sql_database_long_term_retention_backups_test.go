@@ -0,0 +1,50 @@
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMSqlDatabaseLongTermRetentionBackups_byDatabase(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sql_database_long_term_retention_backups", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMSqlDatabaseLongTermRetentionBackups_byDatabase(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "backups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSqlDatabaseLongTermRetentionBackups_byDatabase(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_long_term_retention_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+  database_name       = azurerm_sql_database.test.name
+
+  backup_long_term_retention_policy {
+    weekly_retention = "P1W"
+  }
+}
+
+data "azurerm_sql_database_long_term_retention_backups" "test" {
+  location      = azurerm_resource_group.test.location
+  server_name   = azurerm_sql_server.test.name
+  database_name = azurerm_sql_database.test.name
+
+  depends_on = [azurerm_sql_database_long_term_retention_policy.test]
+}
+`, testAccAzureRMSqlDatabase_basic(data))
+}
@@ -0,0 +1,107 @@
+package helper
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func SQLLongTermRetentionPolicy() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"weekly_retention": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: validate.ISO8601Duration,
+				},
+				"monthly_retention": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: validate.ISO8601Duration,
+				},
+				"yearly_retention": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: validate.ISO8601Duration,
+				},
+				"week_of_year": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 52),
+				},
+				"backup_storage_redundancy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(sql.Local),
+						string(sql.Zone),
+						string(sql.Geo),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func ExpandSQLLongTermRetentionPolicyProperties(input []interface{}) *sql.LongTermRetentionPolicyProperties {
+	props := sql.LongTermRetentionPolicyProperties{}
+
+	if len(input) > 0 && input[0] != nil {
+		policy := input[0].(map[string]interface{})
+
+		if v, ok := policy["weekly_retention"]; ok {
+			props.WeeklyRetention = utils.String(v.(string))
+		}
+		if v, ok := policy["monthly_retention"]; ok {
+			props.MonthlyRetention = utils.String(v.(string))
+		}
+		if v, ok := policy["yearly_retention"]; ok {
+			props.YearlyRetention = utils.String(v.(string))
+		}
+		if v, ok := policy["week_of_year"]; ok {
+			props.WeekOfYear = utils.Int32(int32(v.(int)))
+		}
+		if v, ok := policy["backup_storage_redundancy"]; ok && v.(string) != "" {
+			props.BackupStorageRedundancy = sql.BackupStorageRedundancy(v.(string))
+		}
+	}
+
+	return &props
+}
+
+func FlattenSQLLongTermRetentionPolicy(policy *sql.BackupLongTermRetentionPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	policyObj := make(map[string]interface{})
+
+	if props := policy.LongTermRetentionPolicyProperties; props != nil {
+		if props.WeeklyRetention != nil {
+			policyObj["weekly_retention"] = props.WeeklyRetention
+		}
+		if props.MonthlyRetention != nil {
+			policyObj["monthly_retention"] = props.MonthlyRetention
+		}
+		if props.YearlyRetention != nil {
+			policyObj["yearly_retention"] = props.YearlyRetention
+		}
+		if props.WeekOfYear != nil {
+			policyObj["week_of_year"] = props.WeekOfYear
+		}
+		if props.BackupStorageRedundancy != "" {
+			policyObj["backup_storage_redundancy"] = string(props.BackupStorageRedundancy)
+		}
+	}
+
+	return []interface{}{policyObj}
+}
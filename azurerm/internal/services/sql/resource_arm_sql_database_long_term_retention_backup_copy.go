@@ -0,0 +1,200 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSQLDatabaseLongTermRetentionBackupCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSQLDatabaseLongTermRetentionBackupCopyCreate,
+		Read:   resourceArmSQLDatabaseLongTermRetentionBackupCopyRead,
+		Delete: resourceArmSQLDatabaseLongTermRetentionBackupCopyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": azure.SchemaLocation(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"backup_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_server_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"backup_storage_redundancy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.Local),
+					string(sql.Zone),
+					string(sql.Geo),
+				}, false),
+			},
+
+			"copied_backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSQLDatabaseLongTermRetentionBackupCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.LongTermRetentionBackupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	backupName := d.Get("backup_name").(string)
+
+	parameters := sql.CopyLongTermRetentionBackupParameters{
+		CopyLongTermRetentionBackupParameterProperties: &sql.CopyLongTermRetentionBackupParameterProperties{
+			TargetServerResourceID:           utils.String(d.Get("target_server_resource_id").(string)),
+			TargetDatabaseName:               utils.String(d.Get("target_database_name").(string)),
+			TargetSubscriptionID:             utils.String(d.Get("target_subscription_id").(string)),
+			RequestedBackupStorageRedundancy: sql.BackupStorageRedundancy(d.Get("backup_storage_redundancy").(string)),
+		},
+	}
+
+	// Long Term Retention Backups are addressed by subscription + location, not resource
+	// group, since they outlive the server/database they were taken from.
+	future, err := client.Copy(ctx, location, serverName, databaseName, backupName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error issuing copy request for Long Term Retention Backup %q (Database %q / Server %q / Location %q): %+v", backupName, databaseName, serverName, location, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for copy of Long Term Retention Backup %q (Database %q / Server %q / Location %q): %+v", backupName, databaseName, serverName, location, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving result of copy of Long Term Retention Backup %q (Database %q / Server %q / Location %q): %+v", backupName, databaseName, serverName, location, err)
+	}
+	if result.ID == nil {
+		return fmt.Errorf("Copy of Long Term Retention Backup %q (Database %q / Server %q / Location %q) returned an empty ID", backupName, databaseName, serverName, location)
+	}
+
+	d.SetId(*result.ID)
+
+	return resourceArmSQLDatabaseLongTermRetentionBackupCopyRead(d, meta)
+}
+
+func resourceArmSQLDatabaseLongTermRetentionBackupCopyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.LongTermRetentionBackupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	subscriptionID, location, serverName, databaseName, backupName, err := parseLongTermRetentionBackupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	backup, err := client.Get(ctx, location, serverName, databaseName, backupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(backup.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving copied Long Term Retention Backup %q (Server %q / Location %q): %+v", backupName, serverName, location, err)
+	}
+
+	// `d.Id()` identifies the copied backup in its target scope. `location` is defined as
+	// the *source* backup's location (per `Create`), which is not recoverable from the
+	// copied backup's ID - so, like `server_name`/`database_name`/`target_server_resource_id`,
+	// it's intentionally left untouched here rather than overwritten with the target location.
+	d.Set("backup_name", backupName)
+	d.Set("target_subscription_id", subscriptionID)
+	d.Set("target_database_name", databaseName)
+	d.Set("copied_backup_id", d.Id())
+
+	if props := backup.LongTermRetentionBackupProperties; props != nil && props.BackupStorageRedundancy != "" {
+		d.Set("backup_storage_redundancy", string(props.BackupStorageRedundancy))
+	}
+
+	return nil
+}
+
+func resourceArmSQLDatabaseLongTermRetentionBackupCopyDelete(_ *schema.ResourceData, _ interface{}) error {
+	// There is no API to remove a copied Long Term Retention Backup - this is a
+	// point-in-time operation, so deleting it is a no-op from Terraform's perspective.
+	return nil
+}
+
+func parseLongTermRetentionBackupID(input string) (subscriptionID string, location string, serverName string, databaseName string, backupName string, err error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+
+	path := make(map[string]string)
+	for i := 0; i+1 < len(segments); i += 2 {
+		path[strings.ToLower(segments[i])] = segments[i+1]
+	}
+
+	subscriptionID = path["subscriptions"]
+	location = path["locations"]
+	serverName = path["longtermretentionservers"]
+	databaseName = path["longtermretentiondatabases"]
+	backupName = path["longtermretentionbackups"]
+
+	if subscriptionID == "" || location == "" || serverName == "" || backupName == "" {
+		return "", "", "", "", "", fmt.Errorf("parsing Long Term Retention Backup ID %q: unrecognised format", input)
+	}
+
+	return subscriptionID, location, serverName, databaseName, backupName, nil
+}
@@ -0,0 +1,97 @@
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlDatabaseLongTermRetentionBackupCopy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_database_long_term_retention_backup_copy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseLongTermRetentionBackupCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseLongTermRetentionBackupCopy_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseLongTermRetentionBackupCopyExists("azurerm_sql_database_long_term_retention_backup_copy.test"),
+					resource.TestCheckResourceAttrSet("azurerm_sql_database_long_term_retention_backup_copy.test", "copied_backup_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseLongTermRetentionBackupCopyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.LongTermRetentionBackupsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id := rs.Primary.ID
+		resp, err := client.Get(ctx, rs.Primary.Attributes["location"], rs.Primary.Attributes["target_server_resource_id"], rs.Primary.Attributes["target_database_name"], rs.Primary.Attributes["backup_name"])
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: copied Long Term Retention Backup %q does not exist", id)
+			}
+			return fmt.Errorf("Bad: Get on LongTermRetentionBackupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlDatabaseLongTermRetentionBackupCopyDestroy(s *terraform.State) error {
+	// Copied Long Term Retention Backups have no delete API - this is a point-in-time
+	// operation, so there's nothing to assert has been cleaned up on destroy.
+	return nil
+}
+
+func testAccAzureRMSqlDatabaseLongTermRetentionBackupCopy_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_long_term_retention_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+  database_name       = azurerm_sql_database.test.name
+
+  backup_long_term_retention_policy {
+    weekly_retention = "P1W"
+  }
+}
+
+data "azurerm_sql_database_long_term_retention_backups" "test" {
+  location      = azurerm_resource_group.test.location
+  server_name   = azurerm_sql_server.test.name
+  database_name = azurerm_sql_database.test.name
+
+  depends_on = [azurerm_sql_database_long_term_retention_policy.test]
+}
+
+resource "azurerm_sql_database_long_term_retention_backup_copy" "test" {
+  location                   = azurerm_resource_group.test.location
+  server_name                 = azurerm_sql_server.test.name
+  database_name               = azurerm_sql_database.test.name
+  backup_name                 = data.azurerm_sql_database_long_term_retention_backups.test.backups[0].id
+  target_server_resource_id   = azurerm_sql_server.test.id
+  target_database_name        = azurerm_sql_database.test.name
+  target_subscription_id      = data.azurerm_client_config.test.subscription_id
+  backup_storage_redundancy   = "Geo"
+}
+
+data "azurerm_client_config" "test" {}
+`, testAccAzureRMSqlDatabase_basic(data))
+}
@@ -124,13 +124,27 @@ func resourceArmSQLDatabaseLongTermRetentionPolicyDelete(d *schema.ResourceData,
 	resourceGroup := id.ResourceGroup
 	serverName := id.Path["servers"]
 
+	// Read the existing policy so the storage redundancy setting is preserved rather than
+	// clobbered by the reset to default retention values below. If the database (or its
+	// policy) has already been removed out-of-band there's nothing to preserve, so fall
+	// through to the defaults instead of failing the destroy.
+	backupStorageRedundancy := sql.BackupStorageRedundancy("")
+	existing, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+		return fmt.Errorf("Error retrieving Long Term Policies for Database %q (SQL Server %q ;Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+	if err == nil && existing.LongTermRetentionPolicyProperties != nil {
+		backupStorageRedundancy = existing.LongTermRetentionPolicyProperties.BackupStorageRedundancy
+	}
+
 	// Update to default values for removal
 	backupLongTermPolicy := sql.BackupLongTermRetentionPolicy{
 		LongTermRetentionPolicyProperties: &sql.LongTermRetentionPolicyProperties{
-			WeeklyRetention:  utils.String("P0W"),
-			MonthlyRetention: utils.String("P0W"),
-			YearlyRetention:  utils.String("P0W"),
-			WeekOfYear:       utils.Int32(1),
+			WeeklyRetention:         utils.String("P0W"),
+			MonthlyRetention:        utils.String("P0W"),
+			YearlyRetention:         utils.String("P0W"),
+			WeekOfYear:              utils.Int32(1),
+			BackupStorageRedundancy: backupStorageRedundancy,
 		},
 	}
 
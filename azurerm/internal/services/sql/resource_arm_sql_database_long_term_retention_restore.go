@@ -0,0 +1,162 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSQLDatabaseLongTermRetentionRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSQLDatabaseLongTermRetentionRestoreCreate,
+		Read:   resourceArmSQLDatabaseLongTermRetentionRestoreRead,
+		Delete: resourceArmSQLDatabaseLongTermRetentionRestoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"long_term_retention_backup_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocation(),
+		},
+	}
+}
+
+func resourceArmSQLDatabaseLongTermRetentionRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	backupID := d.Get("long_term_retention_backup_id").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	existing, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_sql_database_long_term_retention_restore", *existing.ID)
+	}
+
+	database := sql.Database{
+		Location: utils.String(location),
+		DatabaseProperties: &sql.DatabaseProperties{
+			CreateMode:                        sql.CreateModeRestoreLongTermRetentionBackup,
+			LongTermRetentionBackupResourceID: utils.String(backupID),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, database)
+	if err != nil {
+		return fmt.Errorf("Error issuing create request for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmSQLDatabaseLongTermRetentionRestoreRead(d, meta)
+}
+
+func resourceArmSQLDatabaseLongTermRetentionRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["databases"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	if resp.Location != nil {
+		d.Set("location", azure.NormalizeLocation(*resp.Location))
+	}
+
+	return nil
+}
+
+func resourceArmSQLDatabaseLongTermRetentionRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["databases"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
@@ -0,0 +1,116 @@
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlDatabaseLongTermRetentionRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_database_long_term_retention_restore", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseLongTermRetentionRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseLongTermRetentionRestore_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseLongTermRetentionRestoreExists("azurerm_sql_database_long_term_retention_restore.test"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseLongTermRetentionRestoreExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.DatabasesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Database %q (Server %q / Resource Group %q) does not exist", name, serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on DatabasesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlDatabaseLongTermRetentionRestoreDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.DatabasesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_database_long_term_retention_restore" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("SQL Database %q (Server %q / Resource Group %q) still exists", name, serverName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlDatabaseLongTermRetentionRestore_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_long_term_retention_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+  database_name       = azurerm_sql_database.test.name
+
+  backup_long_term_retention_policy {
+    weekly_retention = "P1W"
+  }
+}
+
+data "azurerm_sql_database_long_term_retention_backups" "test" {
+  location      = azurerm_resource_group.test.location
+  server_name   = azurerm_sql_server.test.name
+  database_name = azurerm_sql_database.test.name
+
+  depends_on = [azurerm_sql_database_long_term_retention_policy.test]
+}
+
+resource "azurerm_sql_database_long_term_retention_restore" "test" {
+  name                          = "acctestdb-restore-%d"
+  resource_group_name           = azurerm_resource_group.test.name
+  server_name                   = azurerm_sql_server.test.name
+  location                      = azurerm_resource_group.test.location
+  long_term_retention_backup_id = data.azurerm_sql_database_long_term_retention_backups.test.backups[0].id
+}
+`, testAccAzureRMSqlDatabase_basic(data), data.RandomInteger)
+}
@@ -0,0 +1,148 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSQLDatabaseShortTermRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSQLDatabaseShortTermRetentionPolicyCreateUpdate,
+		Read:   resourceArmSQLDatabaseShortTermRetentionPolicyRead,
+		Update: resourceArmSQLDatabaseShortTermRetentionPolicyCreateUpdate,
+		Delete: resourceArmSQLDatabaseShortTermRetentionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 35),
+			},
+		},
+	}
+}
+
+func resourceArmSQLDatabaseShortTermRetentionPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.BackupShortTermRetentionPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseName := d.Get("database_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	retentionDays := d.Get("retention_days").(int)
+
+	backupShortTermPolicy := sql.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &sql.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(retentionDays)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, backupShortTermPolicy)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for SQL Server %q (Database %q) Short Term Retention Policies (Resource Group %q): %+v", serverName, databaseName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Create/Update for SQL Server %q (Database %q) Short Term Retention Policies (Resource Group %q): %+v", serverName, databaseName, resourceGroup, err)
+	}
+
+	response, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error issuing get request for Database %q Short Term Policies (SQL Server %q ,Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+	d.SetId(*response.ID)
+
+	return resourceArmSQLDatabaseShortTermRetentionPolicyRead(d, meta)
+}
+
+func resourceArmSQLDatabaseShortTermRetentionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.BackupShortTermRetentionPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseName := id.Path["databases"]
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	backupShortTermPolicy, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Short Term Policies for Database %q (SQL Server %q ;Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if props := backupShortTermPolicy.BackupShortTermRetentionPolicyProperties; props != nil {
+		d.Set("retention_days", props.RetentionDays)
+	}
+
+	return nil
+}
+
+func resourceArmSQLDatabaseShortTermRetentionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.BackupShortTermRetentionPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseName := id.Path["databases"]
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	// Reset to the service default of 7 days on delete
+	backupShortTermPolicy := sql.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &sql.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(7),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, backupShortTermPolicy)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for SQL Server %q (Database %q) Short Term Retention Policies (Resource Group %q): %+v", serverName, databaseName, resourceGroup, err)
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
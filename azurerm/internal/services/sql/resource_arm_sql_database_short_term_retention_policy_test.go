@@ -0,0 +1,139 @@
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlDatabaseShortTermRetentionPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_database_short_term_retention_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseShortTermRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseShortTermRetentionPolicy_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseShortTermRetentionPolicyExists("azurerm_sql_database_short_term_retention_policy.test"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabaseShortTermRetentionPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_database_short_term_retention_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseShortTermRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabaseShortTermRetentionPolicy_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseShortTermRetentionPolicyExists("azurerm_sql_database_short_term_retention_policy.test"),
+					resource.TestCheckResourceAttr("azurerm_sql_database_short_term_retention_policy.test", "retention_days", "7"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabaseShortTermRetentionPolicy_updated(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseShortTermRetentionPolicyExists("azurerm_sql_database_short_term_retention_policy.test"),
+					resource.TestCheckResourceAttr("azurerm_sql_database_short_term_retention_policy.test", "retention_days", "14"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseShortTermRetentionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.BackupShortTermRetentionPoliciesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Short Term Retention Policy for Database %q (Server %q / Resource Group %q) does not exist", databaseName, serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on BackupShortTermRetentionPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlDatabaseShortTermRetentionPolicyDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.BackupShortTermRetentionPoliciesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_database_short_term_retention_policy" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+			return err
+		}
+
+		if props := resp.BackupShortTermRetentionPolicyProperties; props != nil && props.RetentionDays != nil && *props.RetentionDays != 7 {
+			return fmt.Errorf("Short Term Retention Policy for Database %q (Server %q / Resource Group %q) still exists with a non-default retention", databaseName, serverName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlDatabaseShortTermRetentionPolicy_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_short_term_retention_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+  database_name       = azurerm_sql_database.test.name
+  retention_days      = 7
+}
+`, testAccAzureRMSqlDatabase_basic(data))
+}
+
+func testAccAzureRMSqlDatabaseShortTermRetentionPolicy_updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database_short_term_retention_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+  database_name       = azurerm_sql_database.test.name
+  retention_days      = 14
+}
+`, testAccAzureRMSqlDatabase_basic(data))
+}